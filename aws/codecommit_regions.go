@@ -0,0 +1,63 @@
+package aws
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/endpoints"
+	"github.com/aws/aws-sdk-go/service/codecommit"
+)
+
+// codeCommitSupportedRegions lists the partitions' regions that currently
+// host a CodeCommit endpoint, per the SDK's endpoint metadata. It backs the
+// diagnostic raised when CreateRepository fails because the configured
+// region (or an `endpoints.codecommit` override) doesn't resolve.
+func codeCommitSupportedRegions() []string {
+	var regions []string
+
+	resolver := endpoints.DefaultResolver()
+	for _, partition := range resolver.(endpoints.EnumPartitions).Partitions() {
+		svc, ok := partition.Services()[codecommit.EndpointsID]
+		if !ok {
+			continue
+		}
+		for region := range svc.Regions() {
+			regions = append(regions, region)
+		}
+	}
+
+	sort.Strings(regions)
+	return regions
+}
+
+// codeCommitUnsupportedRegionError wraps an SDK error that indicates the
+// current region has no CodeCommit endpoint with a diagnostic that lists
+// where CodeCommit is actually available, instead of surfacing the raw
+// "could not find endpoint" error from the SDK. When ignoreUnsupportedRegion
+// is set (the provider's `ignore_unsupported_region` argument), the raw SDK
+// error is returned untouched instead, so callers targeting a region the
+// SDK's endpoint metadata hasn't caught up with yet aren't blocked by it.
+func codeCommitUnsupportedRegionError(region string, ignoreUnsupportedRegion bool, err error) error {
+	if ignoreUnsupportedRegion {
+		return err
+	}
+
+	if !isAWSErr(err, "UnknownEndpointError", "") && !strings.Contains(err.Error(), "could not find endpoint") {
+		return err
+	}
+
+	return fmt.Errorf(
+		"CodeCommit is not available in %s; it is currently supported in: %s",
+		region,
+		strings.Join(codeCommitSupportedRegions(), ", "),
+	)
+}
+
+func codeCommitEndpoint(endpoints map[string]string) *string {
+	if v, ok := endpoints["codecommit"]; ok && v != "" {
+		return aws.String(v)
+	}
+	return nil
+}
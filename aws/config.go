@@ -0,0 +1,64 @@
+package aws
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/codecommit"
+	"github.com/aws/aws-sdk-go/service/sts"
+)
+
+// Config holds the provider-level settings used to build the shared
+// session that every service client is derived from.
+type Config struct {
+	Region    string
+	Endpoints map[string]string
+
+	// IgnoreUnsupportedRegion skips the friendlier CodeCommit
+	// unsupported-region diagnostic, letting the raw SDK error through
+	// instead. This is an escape hatch for when the SDK's endpoint
+	// metadata lags a real region launch.
+	IgnoreUnsupportedRegion bool
+}
+
+// AWSClient holds the per-service SDK clients that resources and data
+// sources reach through meta.(*AWSClient).
+type AWSClient struct {
+	region                  string
+	endpoints               map[string]string
+	ignoreUnsupportedRegion bool
+	codecommitconn          *codecommit.CodeCommit
+	stsconn                 *sts.STS
+}
+
+// Client builds the shared session and the service clients derived from
+// it.
+func (c *Config) Client() (interface{}, error) {
+	sess, err := session.NewSession(&aws.Config{
+		Region: aws.String(c.Region),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error creating AWS session: %s", err)
+	}
+
+	client := &AWSClient{
+		region:                  c.Region,
+		endpoints:               c.Endpoints,
+		ignoreUnsupportedRegion: c.IgnoreUnsupportedRegion,
+	}
+
+	// CodeCommit used to only exist in us-east-1, so this client was
+	// historically pinned there regardless of the provider's configured
+	// region. It's now available in many regions, so it follows the
+	// provider's session like every other service; `endpoints.codecommit`
+	// still lets callers point it at a compatible endpoint (GovCloud,
+	// China partitions, or a local test double) when the default
+	// resolver's endpoint for the configured region isn't the right one.
+	client.codecommitconn = codecommit.New(sess.Copy(&aws.Config{
+		Endpoint: codeCommitEndpoint(c.Endpoints),
+	}))
+	client.stsconn = sts.New(sess)
+
+	return client, nil
+}
@@ -0,0 +1,330 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"sync"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/codecommit"
+	"github.com/aws/aws-sdk-go/service/sts"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+const codeCommitBatchGetRepositoriesLimit = 25
+
+// codeCommitListTagsConcurrency bounds how many ListTagsForResource calls
+// the repositories data source issues in parallel, so that accounts with
+// hundreds of repositories don't serialize one API call per repo (or trip
+// CodeCommit's rate limits by firing them all at once).
+const codeCommitListTagsConcurrency = 10
+
+// codeCommitListTagsWarnThreshold is the repository count above which we
+// log that tag collection may take a while, since every repository still
+// needs its own ListTagsForResource call regardless of tag_filters.
+const codeCommitListTagsWarnThreshold = 100
+
+func dataSourceAwsCodeCommitRepositories() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAwsCodeCommitRepositoriesRead,
+
+		Schema: map[string]*schema.Schema{
+			"role_arn": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"tag_filters": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"key": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"values": {
+							Type:     schema.TypeList,
+							Required: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+
+			"names": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"arns": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"repositories": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"repository_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"clone_url_http": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"clone_url_ssh": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"default_branch": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"tags": {
+							Type:     schema.TypeMap,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceAwsCodeCommitRepositoriesRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).codecommitconn
+
+	if v, ok := d.GetOk("role_arn"); ok {
+		assumedConn, err := codeCommitConnWithAssumedRole(meta.(*AWSClient), v.(string))
+		if err != nil {
+			return fmt.Errorf("error assuming role %s: %s", v.(string), err)
+		}
+		conn = assumedConn
+	}
+
+	repositoryNames, err := listAllCodeCommitRepositoryNames(conn)
+	if err != nil {
+		return fmt.Errorf("error listing CodeCommit Repositories: %s", err)
+	}
+
+	metadatas, err := batchGetCodeCommitRepositories(conn, repositoryNames)
+	if err != nil {
+		return fmt.Errorf("error describing CodeCommit Repositories: %s", err)
+	}
+
+	tagFilters := expandCodeCommitTagFilters(d.Get("tag_filters").([]interface{}))
+
+	if len(metadatas) > codeCommitListTagsWarnThreshold {
+		log.Printf("[WARN] Fetching tags for %d CodeCommit repositories, this may take a while", len(metadatas))
+	}
+
+	tagMaps, err := listCodeCommitRepositoryTags(conn, metadatas)
+	if err != nil {
+		return err
+	}
+
+	var names, arns []string
+	var repositories []map[string]interface{}
+
+	for i, metadata := range metadatas {
+		tagMap := tagMaps[i]
+
+		if !codeCommitTagsMatchFilters(tagMap, tagFilters) {
+			continue
+		}
+
+		names = append(names, aws.StringValue(metadata.RepositoryName))
+		arns = append(arns, aws.StringValue(metadata.Arn))
+
+		repositories = append(repositories, map[string]interface{}{
+			"repository_name": aws.StringValue(metadata.RepositoryName),
+			"arn":             aws.StringValue(metadata.Arn),
+			"clone_url_http":  aws.StringValue(metadata.CloneUrlHttp),
+			"clone_url_ssh":   aws.StringValue(metadata.CloneUrlSsh),
+			"default_branch":  aws.StringValue(metadata.DefaultBranch),
+			"tags":            tagMap,
+		})
+	}
+
+	d.SetId(meta.(*AWSClient).region)
+	d.Set("names", names)
+	d.Set("arns", arns)
+	if err := d.Set("repositories", repositories); err != nil {
+		return fmt.Errorf("error setting repositories: %s", err)
+	}
+
+	return nil
+}
+
+// listCodeCommitRepositoryTags fetches ListTagsForResource for every
+// repository with bounded concurrency, returning tag maps in the same
+// order as metadatas.
+func listCodeCommitRepositoryTags(conn *codecommit.CodeCommit, metadatas []*codecommit.RepositoryMetadata) ([]map[string]string, error) {
+	tagMaps := make([]map[string]string, len(metadatas))
+	errs := make([]error, len(metadatas))
+
+	sem := make(chan struct{}, codeCommitListTagsConcurrency)
+	var wg sync.WaitGroup
+
+	for i, metadata := range metadatas {
+		wg.Add(1)
+		go func(i int, metadata *codecommit.RepositoryMetadata) {
+			defer wg.Done()
+
+			sem <- struct{}{}
+			defer func() { <-sem }()
+
+			tags, err := conn.ListTagsForResource(&codecommit.ListTagsForResourceInput{
+				ResourceArn: metadata.Arn,
+			})
+			if err != nil {
+				errs[i] = fmt.Errorf("error listing tags for CodeCommit Repository (%s): %s", aws.StringValue(metadata.RepositoryName), err)
+				return
+			}
+
+			tagMaps[i] = tagsToMapCodeCommit(tags.Tags)
+		}(i, metadata)
+	}
+
+	wg.Wait()
+
+	for _, err := range errs {
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return tagMaps, nil
+}
+
+func codeCommitConnWithAssumedRole(client *AWSClient, roleArn string) (*codecommit.CodeCommit, error) {
+	stsConn := client.stsconn
+
+	creds, err := stsConn.AssumeRole(&sts.AssumeRoleInput{
+		RoleArn:         aws.String(roleArn),
+		RoleSessionName: aws.String("terraform-aws-codecommit-repositories"),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	assumedCreds := credentials.NewStaticCredentials(
+		aws.StringValue(creds.Credentials.AccessKeyId),
+		aws.StringValue(creds.Credentials.SecretAccessKey),
+		aws.StringValue(creds.Credentials.SessionToken),
+	)
+
+	sess, err := session.NewSession(&aws.Config{
+		Region:      aws.String(client.region),
+		Credentials: assumedCreds,
+		Endpoint:    codeCommitEndpoint(client.endpoints),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return codecommit.New(sess), nil
+}
+
+func listAllCodeCommitRepositoryNames(conn *codecommit.CodeCommit) ([]string, error) {
+	var names []string
+
+	input := &codecommit.ListRepositoriesInput{}
+
+	for {
+		out, err := conn.ListRepositories(input)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, repo := range out.Repositories {
+			names = append(names, aws.StringValue(repo.RepositoryName))
+		}
+
+		if out.NextToken == nil {
+			break
+		}
+		input.NextToken = out.NextToken
+	}
+
+	return names, nil
+}
+
+func batchGetCodeCommitRepositories(conn *codecommit.CodeCommit, names []string) ([]*codecommit.RepositoryMetadata, error) {
+	var metadatas []*codecommit.RepositoryMetadata
+
+	for i := 0; i < len(names); i += codeCommitBatchGetRepositoriesLimit {
+		end := i + codeCommitBatchGetRepositoriesLimit
+		if end > len(names) {
+			end = len(names)
+		}
+
+		out, err := conn.BatchGetRepositories(&codecommit.BatchGetRepositoriesInput{
+			RepositoryNames: aws.StringSlice(names[i:end]),
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		metadatas = append(metadatas, out.Repositories...)
+	}
+
+	return metadatas, nil
+}
+
+type codeCommitTagFilter struct {
+	Key    string
+	Values []string
+}
+
+func expandCodeCommitTagFilters(in []interface{}) []codeCommitTagFilter {
+	filters := make([]codeCommitTagFilter, 0, len(in))
+
+	for _, fRaw := range in {
+		f := fRaw.(map[string]interface{})
+
+		filters = append(filters, codeCommitTagFilter{
+			Key:    f["key"].(string),
+			Values: aws.StringValueSlice(expandStringList(f["values"].([]interface{}))),
+		})
+	}
+
+	return filters
+}
+
+// codeCommitTagsMatchFilters returns true when the repository's tags satisfy
+// every tag filter (a filter matches when any of its values is present for
+// that tag key), i.e. the filters are intersected across keys.
+func codeCommitTagsMatchFilters(tags map[string]string, filters []codeCommitTagFilter) bool {
+	for _, filter := range filters {
+		value, ok := tags[filter.Key]
+		if !ok {
+			return false
+		}
+
+		matched := false
+		for _, v := range filter.Values {
+			if v == value {
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			return false
+		}
+	}
+
+	return true
+}
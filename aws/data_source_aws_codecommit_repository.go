@@ -0,0 +1,119 @@
+package aws
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/codecommit"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func dataSourceAwsCodeCommitRepository() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAwsCodeCommitRepositoryRead,
+
+		Schema: map[string]*schema.Schema{
+			"repository_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"repository_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"clone_url_http": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"clone_url_ssh": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"default_branch": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"kms_key_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"branches": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func dataSourceAwsCodeCommitRepositoryRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).codecommitconn
+
+	repositoryName := d.Get("repository_name").(string)
+
+	out, err := conn.GetRepository(&codecommit.GetRepositoryInput{
+		RepositoryName: aws.String(repositoryName),
+	})
+	if err != nil {
+		return fmt.Errorf("error reading CodeCommit Repository (%s): %s", repositoryName, err)
+	}
+
+	metadata := out.RepositoryMetadata
+
+	d.SetId(aws.StringValue(metadata.RepositoryId))
+	d.Set("repository_id", metadata.RepositoryId)
+	d.Set("arn", metadata.Arn)
+	d.Set("clone_url_http", metadata.CloneUrlHttp)
+	d.Set("clone_url_ssh", metadata.CloneUrlSsh)
+	d.Set("description", metadata.RepositoryDescription)
+	d.Set("default_branch", metadata.DefaultBranch)
+	d.Set("kms_key_id", metadata.KmsKeyId)
+
+	branches, err := listCodeCommitRepositoryBranches(conn, repositoryName)
+	if err != nil {
+		return fmt.Errorf("error listing CodeCommit Repository (%s) branches: %s", repositoryName, err)
+	}
+	d.Set("branches", branches)
+
+	return nil
+}
+
+func listCodeCommitRepositoryBranches(conn *codecommit.CodeCommit, repositoryName string) ([]string, error) {
+	var branches []string
+
+	input := &codecommit.ListBranchesInput{
+		RepositoryName: aws.String(repositoryName),
+	}
+
+	for {
+		out, err := conn.ListBranches(input)
+		if err != nil {
+			return nil, err
+		}
+
+		branches = append(branches, aws.StringValueSlice(out.Branches)...)
+
+		if out.NextToken == nil {
+			break
+		}
+		input.NextToken = out.NextToken
+	}
+
+	return branches, nil
+}
@@ -0,0 +1,72 @@
+package aws
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+// Provider returns the aws provider's schema.Provider, wiring up every
+// resource and data source this package defines.
+func Provider() *schema.Provider {
+	return &schema.Provider{
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"endpoints": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"codecommit": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+
+			"ignore_unsupported_region": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+		},
+
+		ResourcesMap: map[string]*schema.Resource{
+			"aws_codecommit_repository":                        resourceAwsCodeCommitRepository(),
+			"aws_codecommit_trigger":                            resourceAwsCodeCommitTrigger(),
+			"aws_codecommit_approval_rule_template":             resourceAwsCodeCommitApprovalRuleTemplate(),
+			"aws_codecommit_approval_rule_template_association": resourceAwsCodeCommitApprovalRuleTemplateAssociation(),
+		},
+
+		DataSourcesMap: map[string]*schema.Resource{
+			"aws_codecommit_repository":   dataSourceAwsCodeCommitRepository(),
+			"aws_codecommit_repositories": dataSourceAwsCodeCommitRepositories(),
+		},
+
+		ConfigureFunc: providerConfigure,
+	}
+}
+
+func providerConfigure(d *schema.ResourceData) (interface{}, error) {
+	config := Config{
+		Region:                  d.Get("region").(string),
+		IgnoreUnsupportedRegion: d.Get("ignore_unsupported_region").(bool),
+	}
+
+	if v, ok := d.GetOk("endpoints"); ok {
+		endpointsSet := v.(*schema.Set).List()
+		if len(endpointsSet) > 0 {
+			endpoints := make(map[string]string)
+			for k, v := range endpointsSet[0].(map[string]interface{}) {
+				endpoints[k] = v.(string)
+			}
+			config.Endpoints = endpoints
+		}
+	}
+
+	return config.Client()
+}
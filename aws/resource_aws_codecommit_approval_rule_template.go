@@ -0,0 +1,180 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/codecommit"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+)
+
+func resourceAwsCodeCommitApprovalRuleTemplate() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsCodeCommitApprovalRuleTemplateCreate,
+		Read:   resourceAwsCodeCommitApprovalRuleTemplateRead,
+		Update: resourceAwsCodeCommitApprovalRuleTemplateUpdate,
+		Delete: resourceAwsCodeCommitApprovalRuleTemplateDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 100),
+			},
+
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 1000),
+			},
+
+			"content": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsJSON,
+			},
+
+			"approval_rule_template_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"rule_content_sha256": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"creation_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"last_modified_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"last_modified_user": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAwsCodeCommitApprovalRuleTemplateCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).codecommitconn
+
+	input := &codecommit.CreateApprovalRuleTemplateInput{
+		ApprovalRuleTemplateName:    aws.String(d.Get("name").(string)),
+		ApprovalRuleTemplateContent: aws.String(d.Get("content").(string)),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.ApprovalRuleTemplateDescription = aws.String(v.(string))
+	}
+
+	out, err := conn.CreateApprovalRuleTemplate(input)
+	if err != nil {
+		return fmt.Errorf("error creating CodeCommit Approval Rule Template: %s", err)
+	}
+
+	d.SetId(aws.StringValue(out.ApprovalRuleTemplate.ApprovalRuleTemplateName))
+
+	return resourceAwsCodeCommitApprovalRuleTemplateRead(d, meta)
+}
+
+func resourceAwsCodeCommitApprovalRuleTemplateRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).codecommitconn
+
+	out, err := conn.GetApprovalRuleTemplate(&codecommit.GetApprovalRuleTemplateInput{
+		ApprovalRuleTemplateName: aws.String(d.Id()),
+	})
+	if err != nil {
+		if isAWSErr(err, codecommit.ErrCodeApprovalRuleTemplateDoesNotExistException, "") {
+			log.Printf("[WARN] CodeCommit Approval Rule Template (%s) not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("error reading CodeCommit Approval Rule Template (%s): %s", d.Id(), err)
+	}
+
+	art := out.ApprovalRuleTemplate
+
+	d.Set("name", art.ApprovalRuleTemplateName)
+	d.Set("description", art.ApprovalRuleTemplateDescription)
+	d.Set("content", art.ApprovalRuleTemplateContent)
+	d.Set("approval_rule_template_id", art.ApprovalRuleTemplateId)
+	d.Set("rule_content_sha256", art.RuleContentSha256)
+	d.Set("last_modified_user", art.LastModifiedUser)
+
+	if art.CreationDate != nil {
+		d.Set("creation_date", art.CreationDate.Format(time.RFC3339))
+	}
+	if art.LastModifiedDate != nil {
+		d.Set("last_modified_date", art.LastModifiedDate.Format(time.RFC3339))
+	}
+
+	return nil
+}
+
+func resourceAwsCodeCommitApprovalRuleTemplateUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).codecommitconn
+
+	if d.HasChange("description") {
+		_, err := conn.UpdateApprovalRuleTemplateDescription(&codecommit.UpdateApprovalRuleTemplateDescriptionInput{
+			ApprovalRuleTemplateName:        aws.String(d.Id()),
+			ApprovalRuleTemplateDescription: aws.String(d.Get("description").(string)),
+		})
+		if err != nil {
+			return fmt.Errorf("error updating CodeCommit Approval Rule Template (%s) description: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("content") {
+		_, err := conn.UpdateApprovalRuleTemplateContent(&codecommit.UpdateApprovalRuleTemplateContentInput{
+			ApprovalRuleTemplateName:  aws.String(d.Id()),
+			NewRuleContent:            aws.String(d.Get("content").(string)),
+			ExistingRuleContentSha256: aws.String(d.Get("rule_content_sha256").(string)),
+		})
+		if err != nil {
+			return fmt.Errorf("error updating CodeCommit Approval Rule Template (%s) content: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("name") {
+		out, err := conn.UpdateApprovalRuleTemplateName(&codecommit.UpdateApprovalRuleTemplateNameInput{
+			OldApprovalRuleTemplateName: aws.String(d.Id()),
+			NewApprovalRuleTemplateName: aws.String(d.Get("name").(string)),
+		})
+		if err != nil {
+			return fmt.Errorf("error renaming CodeCommit Approval Rule Template (%s): %s", d.Id(), err)
+		}
+		d.SetId(aws.StringValue(out.ApprovalRuleTemplate.ApprovalRuleTemplateName))
+	}
+
+	return resourceAwsCodeCommitApprovalRuleTemplateRead(d, meta)
+}
+
+func resourceAwsCodeCommitApprovalRuleTemplateDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).codecommitconn
+
+	log.Printf("[DEBUG] CodeCommit Delete Approval Rule Template: %s", d.Id())
+	_, err := conn.DeleteApprovalRuleTemplate(&codecommit.DeleteApprovalRuleTemplateInput{
+		ApprovalRuleTemplateName: aws.String(d.Id()),
+	})
+	if err != nil {
+		if isAWSErr(err, codecommit.ErrCodeApprovalRuleTemplateDoesNotExistException, "") {
+			return nil
+		}
+		return fmt.Errorf("error deleting CodeCommit Approval Rule Template (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
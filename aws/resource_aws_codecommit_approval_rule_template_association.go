@@ -0,0 +1,141 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/codecommit"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func resourceAwsCodeCommitApprovalRuleTemplateAssociation() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsCodeCommitApprovalRuleTemplateAssociationCreate,
+		Read:   resourceAwsCodeCommitApprovalRuleTemplateAssociationRead,
+		Delete: resourceAwsCodeCommitApprovalRuleTemplateAssociationDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsCodeCommitApprovalRuleTemplateAssociationImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"approval_rule_template_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"repository_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceAwsCodeCommitApprovalRuleTemplateAssociationCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).codecommitconn
+
+	templateName := d.Get("approval_rule_template_name").(string)
+	repositoryName := d.Get("repository_name").(string)
+
+	_, err := conn.AssociateApprovalRuleTemplateWithRepository(&codecommit.AssociateApprovalRuleTemplateWithRepositoryInput{
+		ApprovalRuleTemplateName: aws.String(templateName),
+		RepositoryName:           aws.String(repositoryName),
+	})
+	if err != nil {
+		return fmt.Errorf("error associating CodeCommit Approval Rule Template (%s) with Repository (%s): %s", templateName, repositoryName, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s,%s", templateName, repositoryName))
+
+	return resourceAwsCodeCommitApprovalRuleTemplateAssociationRead(d, meta)
+}
+
+func resourceAwsCodeCommitApprovalRuleTemplateAssociationRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).codecommitconn
+
+	templateName := d.Get("approval_rule_template_name").(string)
+	repositoryName := d.Get("repository_name").(string)
+
+	associated, err := codeCommitRepositoryHasApprovalRuleTemplate(conn, repositoryName, templateName)
+	if err != nil {
+		if isAWSErr(err, codecommit.ErrCodeRepositoryDoesNotExistException, "") {
+			log.Printf("[WARN] CodeCommit Approval Rule Template Association (%s) not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("error reading CodeCommit Approval Rule Template Association (%s): %s", d.Id(), err)
+	}
+
+	if !associated {
+		log.Printf("[WARN] CodeCommit Approval Rule Template Association (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("approval_rule_template_name", templateName)
+	d.Set("repository_name", repositoryName)
+
+	return nil
+}
+
+func codeCommitRepositoryHasApprovalRuleTemplate(conn *codecommit.CodeCommit, repositoryName, templateName string) (bool, error) {
+	input := &codecommit.ListAssociatedApprovalRuleTemplatesForRepositoryInput{
+		RepositoryName: aws.String(repositoryName),
+	}
+
+	for {
+		out, err := conn.ListAssociatedApprovalRuleTemplatesForRepository(input)
+		if err != nil {
+			return false, err
+		}
+
+		for _, name := range out.ApprovalRuleTemplateNames {
+			if aws.StringValue(name) == templateName {
+				return true, nil
+			}
+		}
+
+		if out.NextToken == nil {
+			return false, nil
+		}
+		input.NextToken = out.NextToken
+	}
+}
+
+func resourceAwsCodeCommitApprovalRuleTemplateAssociationDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).codecommitconn
+
+	templateName := d.Get("approval_rule_template_name").(string)
+	repositoryName := d.Get("repository_name").(string)
+
+	log.Printf("[DEBUG] CodeCommit Disassociate Approval Rule Template (%s) from Repository (%s)", templateName, repositoryName)
+	_, err := conn.DisassociateApprovalRuleTemplateFromRepository(&codecommit.DisassociateApprovalRuleTemplateFromRepositoryInput{
+		ApprovalRuleTemplateName: aws.String(templateName),
+		RepositoryName:           aws.String(repositoryName),
+	})
+	if err != nil {
+		if isAWSErr(err, codecommit.ErrCodeApprovalRuleTemplateDoesNotExistException, "") ||
+			isAWSErr(err, codecommit.ErrCodeRepositoryDoesNotExistException, "") {
+			return nil
+		}
+		return fmt.Errorf("error disassociating CodeCommit Approval Rule Template (%s) from Repository (%s): %s", templateName, repositoryName, err)
+	}
+
+	return nil
+}
+
+func resourceAwsCodeCommitApprovalRuleTemplateAssociationImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.Split(d.Id(), ",")
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("expected ID in the form of approval_rule_template_name,repository_name, got: %s", d.Id())
+	}
+
+	d.Set("approval_rule_template_name", parts[0])
+	d.Set("repository_name", parts[1])
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -3,13 +3,19 @@ package aws
 import (
 	"fmt"
 	"log"
+	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/service/codecommit"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
 )
 
+// codeCommitDefaultKmsAlias is the alias CodeCommit falls back to when a
+// repository's customer-managed KMS key is removed.
+const codeCommitDefaultKmsAlias = "alias/aws/codecommit"
+
 func resourceAwsCodeCommitRepository() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceAwsCodeCommitRepositoryCreate,
@@ -58,6 +64,22 @@ func resourceAwsCodeCommitRepository() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+
+			"kms_key_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateCodeCommitKmsKeyId,
+				// The API always returns the key's concrete ID/ARN from
+				// RepositoryMetadata.KmsKeyId, even when the config uses an
+				// alias or a bare key ID, so a literal string compare would
+				// show a perpetual diff. A key ID is always a substring of
+				// its own ARN, so suppress when one side is contained in the
+				// other. An alias is not resolved to its key, so a config
+				// that uses an alias still differs from the returned key.
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					return old != "" && new != "" && (strings.Contains(old, new) || strings.Contains(new, old))
+				},
+			},
 			"tags": tagsSchema(),
 		},
 	}
@@ -72,9 +94,14 @@ func resourceAwsCodeCommitRepositoryCreate(d *schema.ResourceData, meta interfac
 		Tags:                  tagsFromMapCodeCommit(d.Get("tags").(map[string]interface{})),
 	}
 
+	if v, ok := d.GetOk("kms_key_id"); ok {
+		input.KmsKeyId = aws.String(v.(string))
+	}
+
 	out, err := conn.CreateRepository(input)
 	if err != nil {
-		return fmt.Errorf("Error creating CodeCommit Repository: %s", err)
+		client := meta.(*AWSClient)
+		return fmt.Errorf("Error creating CodeCommit Repository: %s", codeCommitUnsupportedRegionError(client.region, client.ignoreUnsupportedRegion, err))
 	}
 
 	d.SetId(d.Get("repository_name").(string))
@@ -103,6 +130,12 @@ func resourceAwsCodeCommitRepositoryUpdate(d *schema.ResourceData, meta interfac
 		}
 	}
 
+	if !d.IsNewResource() && d.HasChange("kms_key_id") {
+		if err := resourceAwsCodeCommitUpdateEncryptionKey(conn, d); err != nil {
+			return err
+		}
+	}
+
 	if !d.IsNewResource() {
 		if err := setTagsCodeCommit(conn, d); err != nil {
 			return fmt.Errorf("error updating CodeCommit Repository tags for %s: %s", d.Id(), err)
@@ -135,6 +168,7 @@ func resourceAwsCodeCommitRepositoryRead(d *schema.ResourceData, meta interface{
 	d.Set("clone_url_ssh", out.RepositoryMetadata.CloneUrlSsh)
 	d.Set("description", out.RepositoryMetadata.RepositoryDescription)
 	d.Set("repository_name", out.RepositoryMetadata.RepositoryName)
+	d.Set("kms_key_id", out.RepositoryMetadata.KmsKeyId)
 
 	if _, ok := d.GetOk("default_branch"); ok {
 		if out.RepositoryMetadata.DefaultBranch != nil {
@@ -184,6 +218,37 @@ func resourceAwsCodeCommitUpdateDescription(conn *codecommit.CodeCommit, d *sche
 	return nil
 }
 
+func resourceAwsCodeCommitUpdateEncryptionKey(conn *codecommit.CodeCommit, d *schema.ResourceData) error {
+	kmsKeyId := d.Get("kms_key_id").(string)
+	if kmsKeyId == "" {
+		kmsKeyId = codeCommitDefaultKmsAlias
+	}
+
+	input := &codecommit.UpdateRepositoryEncryptionKeyInput{
+		RepositoryName: aws.String(d.Id()),
+		KmsKeyId:       aws.String(kmsKeyId),
+	}
+
+	_, err := conn.UpdateRepositoryEncryptionKey(input)
+	if err != nil {
+		return fmt.Errorf("Error Updating Encryption Key for CodeCommit Repository: %s", err.Error())
+	}
+
+	return nil
+}
+
+func validateCodeCommitKmsKeyId(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+
+	if strings.HasPrefix(value, "arn:") {
+		if _, err := arn.Parse(value); err != nil {
+			errors = append(errors, fmt.Errorf("%q (%s) is an invalid ARN: %s", k, value, err))
+		}
+	}
+
+	return
+}
+
 func resourceAwsCodeCommitUpdateDefaultBranch(conn *codecommit.CodeCommit, d *schema.ResourceData) error {
 	input := &codecommit.ListBranchesInput{
 		RepositoryName: aws.String(d.Id()),
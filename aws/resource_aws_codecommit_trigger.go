@@ -0,0 +1,192 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/codecommit"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+)
+
+func resourceAwsCodeCommitTrigger() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsCodeCommitTriggerCreate,
+		Read:   resourceAwsCodeCommitTriggerRead,
+		Delete: resourceAwsCodeCommitTriggerDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"repository_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(0, 100),
+			},
+
+			"configuration_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"trigger": {
+				Type:     schema.TypeSet,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 10,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+
+						"destination_arn": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+
+						"custom_data": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+
+						"branches": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+
+						"events": {
+							Type:     schema.TypeList,
+							Required: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+								ValidateFunc: validation.StringInSlice([]string{
+									codecommit.RepositoryTriggerEventEnumAll,
+									codecommit.RepositoryTriggerEventEnumUpdateReference,
+									codecommit.RepositoryTriggerEventEnumCreateReference,
+									codecommit.RepositoryTriggerEventEnumDeleteReference,
+								}, false),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceAwsCodeCommitTriggerCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).codecommitconn
+
+	repositoryName := d.Get("repository_name").(string)
+
+	input := &codecommit.PutRepositoryTriggersInput{
+		RepositoryName: aws.String(repositoryName),
+		Triggers:       expandCodeCommitTriggers(d.Get("trigger").(*schema.Set).List()),
+	}
+
+	out, err := conn.PutRepositoryTriggers(input)
+	if err != nil {
+		return fmt.Errorf("error creating CodeCommit Repository Triggers: %s", err)
+	}
+
+	d.SetId(repositoryName)
+	d.Set("configuration_id", out.ConfigurationId)
+
+	return resourceAwsCodeCommitTriggerRead(d, meta)
+}
+
+func resourceAwsCodeCommitTriggerRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).codecommitconn
+
+	input := &codecommit.GetRepositoryTriggersInput{
+		RepositoryName: aws.String(d.Id()),
+	}
+
+	out, err := conn.GetRepositoryTriggers(input)
+	if err != nil {
+		if isAWSErr(err, codecommit.ErrCodeRepositoryDoesNotExistException, "") {
+			log.Printf("[WARN] CodeCommit Repository (%s) not found, removing triggers from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("error reading CodeCommit Repository Triggers: %s", err.Error())
+	}
+
+	if len(out.Triggers) == 0 {
+		log.Printf("[WARN] CodeCommit Repository (%s) has no triggers, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("repository_name", d.Id())
+	d.Set("configuration_id", out.ConfigurationId)
+	if err := d.Set("trigger", flattenCodeCommitTriggers(out.Triggers)); err != nil {
+		return fmt.Errorf("error setting trigger: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsCodeCommitTriggerDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).codecommitconn
+
+	log.Printf("[DEBUG] CodeCommit Delete Repository Triggers: %s", d.Id())
+	_, err := conn.PutRepositoryTriggers(&codecommit.PutRepositoryTriggersInput{
+		RepositoryName: aws.String(d.Id()),
+		Triggers:       []*codecommit.RepositoryTrigger{},
+	})
+	if err != nil {
+		if isAWSErr(err, codecommit.ErrCodeRepositoryDoesNotExistException, "") {
+			return nil
+		}
+		return fmt.Errorf("error deleting CodeCommit Repository Triggers: %s", err.Error())
+	}
+
+	return nil
+}
+
+func expandCodeCommitTriggers(in []interface{}) []*codecommit.RepositoryTrigger {
+	triggers := make([]*codecommit.RepositoryTrigger, 0, len(in))
+
+	for _, tRaw := range in {
+		t := tRaw.(map[string]interface{})
+
+		trigger := &codecommit.RepositoryTrigger{
+			Name:           aws.String(t["name"].(string)),
+			DestinationArn: aws.String(t["destination_arn"].(string)),
+			Events:         expandStringList(t["events"].([]interface{})),
+			Branches:       expandStringList(t["branches"].([]interface{})),
+		}
+
+		if v, ok := t["custom_data"]; ok {
+			trigger.CustomData = aws.String(v.(string))
+		}
+
+		triggers = append(triggers, trigger)
+	}
+
+	return triggers
+}
+
+func flattenCodeCommitTriggers(triggers []*codecommit.RepositoryTrigger) []map[string]interface{} {
+	out := make([]map[string]interface{}, 0, len(triggers))
+
+	for _, t := range triggers {
+		m := map[string]interface{}{
+			"name":            aws.StringValue(t.Name),
+			"destination_arn": aws.StringValue(t.DestinationArn),
+			"custom_data":     aws.StringValue(t.CustomData),
+			"branches":        aws.StringValueSlice(t.Branches),
+			"events":          aws.StringValueSlice(t.Events),
+		}
+		out = append(out, m)
+	}
+
+	return out
+}